@@ -0,0 +1,64 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/shurcooL/githubv4"
+	"golang.org/x/oauth2"
+)
+
+// Config is gathered from the provider's top-level schema block and is
+// used to build the Owner every resource and data source receives as
+// meta.
+type Config struct {
+	Token     string
+	Owner     string
+	BaseURL   string
+	HTTPCache *HTTPCacheConfig
+}
+
+// Owner wraps the authenticated v3 (REST) and v4 (GraphQL) clients
+// shared across this provider's resources and data sources.
+type Owner struct {
+	name     string
+	v3client *github.Client
+	v4client *githubv4.Client
+}
+
+// Meta builds the Owner from Config, wiring the rate-limit and
+// conditional-request caching transports into the v3 client's
+// underlying http.Client so every resource and data source benefits
+// from them without having to know the transport exists.
+func (c *Config) Meta() (*Owner, error) {
+	ctx := context.Background()
+
+	httpClient := &http.Client{}
+	if c.Token != "" {
+		httpClient = oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: c.Token,
+		}))
+	}
+	baseTransport := httpClient.Transport
+	if baseTransport == nil {
+		baseTransport = http.DefaultTransport
+	}
+	httpClient.Transport = newHTTPCacheTransport(baseTransport, c.HTTPCache)
+
+	v3client := github.NewClient(httpClient)
+	if c.BaseURL != "" {
+		baseURL, err := url.Parse(c.BaseURL)
+		if err != nil {
+			return nil, err
+		}
+		v3client.BaseURL = baseURL
+	}
+
+	return &Owner{
+		name:     c.Owner,
+		v3client: v3client,
+		v4client: githubv4.NewClient(httpClient),
+	}, nil
+}
@@ -0,0 +1,98 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceGithubIssueComment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubIssueCommentRead,
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"comment_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"issue_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"body": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"author_association": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGithubIssueCommentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+	commentID := int64(d.Get("comment_id").(int))
+
+	ctx := context.Background()
+	comment, _, err := client.Issues.GetComment(ctx, owner, repo, commentID)
+	if err != nil {
+		return err
+	}
+
+	issueNumber, err := issueNumberFromCommentURL(comment.GetIssueURL())
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", owner, repo, commentID))
+	d.Set("issue_number", issueNumber)
+	d.Set("body", comment.GetBody())
+	d.Set("node_id", comment.GetNodeID())
+	d.Set("author_association", comment.GetAuthorAssociation())
+	d.Set("html_url", comment.GetHTMLURL())
+	d.Set("created_at", comment.GetCreatedAt().Format(time.RFC3339))
+	d.Set("updated_at", comment.GetUpdatedAt().Format(time.RFC3339))
+
+	return nil
+}
+
+func issueNumberFromCommentURL(issueURL string) (int, error) {
+	parts := strings.Split(issueURL, "/")
+	if len(parts) == 0 {
+		return 0, fmt.Errorf("Unable to parse issue number from URL: %s", issueURL)
+	}
+	return strconv.Atoi(parts[len(parts)-1])
+}
@@ -0,0 +1,261 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceGithubIssues() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGithubIssuesRead,
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"search_query": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"open", "closed", "all",
+				}, true),
+				Default: "open",
+			},
+			"labels": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"assignee": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"creator": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mentioned": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"milestone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"since": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sort": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"created", "updated", "comments",
+				}, true),
+				Default: "created",
+			},
+			"direction": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"asc", "desc",
+				}, true),
+				Default: "desc",
+			},
+			"max_results": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
+			},
+			"issues": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"issue_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"body": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"assignees": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"milestone_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"html_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubIssuesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.Background()
+
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+	maxResults := d.Get("max_results").(int)
+
+	var issues []*github.Issue
+
+	if searchQuery, ok := d.GetOk("search_query"); ok {
+		query := searchQuery.(string)
+		opts := &github.SearchOptions{
+			Sort:  d.Get("sort").(string),
+			Order: d.Get("direction").(string),
+			ListOptions: github.ListOptions{
+				PerPage: 100,
+			},
+		}
+
+		for {
+			result, resp, err := client.Search.Issues(ctx, query, opts)
+			if err != nil {
+				return err
+			}
+			issues = append(issues, result.Issues...)
+			if resp.NextPage == 0 || len(issues) >= maxResults {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+	} else {
+		if repo == "" {
+			return fmt.Errorf("repository is required when search_query is not set")
+		}
+
+		since, err := expandIssuesSince(d.Get("since").(string))
+		if err != nil {
+			return err
+		}
+
+		opts := &github.IssueListByRepoOptions{
+			State:     d.Get("state").(string),
+			Labels:    expandStringList(d.Get("labels").([]interface{})),
+			Assignee:  d.Get("assignee").(string),
+			Creator:   d.Get("creator").(string),
+			Mentioned: d.Get("mentioned").(string),
+			Milestone: d.Get("milestone").(string),
+			Since:     since,
+			Sort:      d.Get("sort").(string),
+			Direction: d.Get("direction").(string),
+			ListOptions: github.ListOptions{
+				PerPage: 100,
+			},
+		}
+
+		for {
+			page, resp, err := client.Issues.ListByRepo(ctx, owner, repo, opts)
+			if err != nil {
+				return err
+			}
+			issues = append(issues, page...)
+			if resp.NextPage == 0 || len(issues) >= maxResults {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+	}
+
+	if len(issues) > maxResults {
+		issues = issues[:maxResults]
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", owner, repo))
+	d.Set("issues", flattenIssues(issues))
+
+	return nil
+}
+
+func flattenIssues(issues []*github.Issue) []interface{} {
+	flattened := make([]interface{}, 0, len(issues))
+	for _, issue := range issues {
+		milestoneNumber := 0
+		if milestone := issue.GetMilestone(); milestone != nil {
+			milestoneNumber = milestone.GetNumber()
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"number":           issue.GetNumber(),
+			"issue_id":         issue.GetID(),
+			"title":            issue.GetTitle(),
+			"body":             issue.GetBody(),
+			"labels":           expandIssueLabels(issue.Labels),
+			"assignees":        expandIssueUsers(issue.Assignees),
+			"milestone_number": milestoneNumber,
+			"state":            issue.GetState(),
+			"html_url":         issue.GetHTMLURL(),
+			"created_at":       issue.GetCreatedAt().Format(time.RFC3339),
+			"updated_at":       issue.GetUpdatedAt().Format(time.RFC3339),
+		})
+	}
+	return flattened
+}
+
+func expandIssuesSince(since string) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("since must be an RFC3339 timestamp: %w", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,150 @@
+package exporter
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+)
+
+// lowRateLimitThreshold is how many requests of budget must remain
+// before the exporter proactively sleeps until the window resets,
+// rather than burning through an org's entire quota on a single sync.
+const lowRateLimitThreshold = 5
+
+// Exporter synchronizes a directory of file-backed issue definitions
+// into a single GitHub repository.
+type Exporter struct {
+	Client *github.Client
+	Owner  string
+	Repo   string
+}
+
+// New returns an Exporter for the given owner/repo using client.
+func New(client *github.Client, owner, repo string) *Exporter {
+	return &Exporter{
+		Client: client,
+		Owner:  owner,
+		Repo:   repo,
+	}
+}
+
+// Sync reconciles definitions against GitHub, starting from the
+// previously recorded state, and returns the updated state. Issues are
+// created if they have no recorded mapping, otherwise their title,
+// body, labels, assignees and milestone are pushed via Edit. Any
+// comments beyond PushedCommentCount's position are appended.
+func (e *Exporter) Sync(ctx context.Context, definitions []*IssueDefinition, state SyncState) (SyncState, error) {
+	if state == nil {
+		state = SyncState{}
+	}
+
+	for _, def := range definitions {
+		existing := state[def.SourcePath]
+
+		var (
+			issue *github.Issue
+			resp  *github.Response
+			err   error
+		)
+
+		req := &github.IssueRequest{
+			Title:     github.String(def.Title),
+			Body:      github.String(def.Body),
+			Labels:    &def.Labels,
+			Assignees: &def.Assignees,
+		}
+		if def.Milestone != 0 {
+			req.Milestone = github.Int(def.Milestone)
+		}
+
+		if existing == nil {
+			log.Printf("[DEBUG] exporter: creating issue for %s", def.SourcePath)
+			issue, resp, err = e.Client.Issues.Create(ctx, e.Owner, e.Repo, req)
+			if err != nil {
+				return state, err
+			}
+			existing = &IssueState{}
+			state[def.SourcePath] = existing
+		} else {
+			log.Printf("[DEBUG] exporter: updating issue #%d for %s", existing.IssueNumber, def.SourcePath)
+			issue, resp, err = e.Client.Issues.Edit(ctx, e.Owner, e.Repo, existing.IssueNumber, req)
+			if err != nil {
+				return state, err
+			}
+		}
+
+		if err := e.backoff(ctx, resp); err != nil {
+			return state, err
+		}
+
+		existing.IssueNumber = issue.GetNumber()
+		existing.LastExportedETag = issue.GetNodeID()
+
+		if err := e.syncComments(ctx, existing, def); err != nil {
+			return state, err
+		}
+	}
+
+	return state, nil
+}
+
+// syncComments appends any comments in def that come after the number
+// of comments we have already pushed for this issue, as recorded in
+// state.PushedCommentCount. This is tracked independently of how many
+// comments currently exist on the GitHub issue, since that total also
+// includes replies from maintainers, bots, etc. that the exporter must
+// never count as "already exported".
+func (e *Exporter) syncComments(ctx context.Context, state *IssueState, def *IssueDefinition) error {
+	start := state.PushedCommentCount
+	if start > len(def.Comments) {
+		start = len(def.Comments)
+	}
+
+	for _, body := range def.Comments[start:] {
+		comment, resp, err := e.Client.Issues.CreateComment(ctx, e.Owner, e.Repo, state.IssueNumber, &github.IssueComment{
+			Body: github.String(body),
+		})
+		if err != nil {
+			return err
+		}
+		if err := e.backoff(ctx, resp); err != nil {
+			return err
+		}
+		state.LastCommentID = comment.GetID()
+		state.PushedCommentCount++
+	}
+
+	return nil
+}
+
+// backoff honors GitHub's rate-limit headers: once the remaining
+// budget drops to lowRateLimitThreshold or below it sleeps until the window
+// resets instead of letting the next call come back as a 403.
+func (e *Exporter) backoff(ctx context.Context, resp *github.Response) error {
+	if resp == nil {
+		return nil
+	}
+
+	if resp.Rate.Remaining > lowRateLimitThreshold {
+		return nil
+	}
+
+	sleep := time.Until(resp.Rate.Reset.Time)
+	if sleep <= 0 {
+		return nil
+	}
+
+	log.Printf("[WARN] exporter: rate limit nearly exhausted (%d remaining), sleeping %s until reset", resp.Rate.Remaining, sleep)
+
+	timer := time.NewTimer(sleep)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,79 @@
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v32/github"
+)
+
+// TestExporterSyncCommentsSkipsExternalComments guards against counting
+// comments GitHub already has (e.g. a maintainer reply) as comments the
+// exporter itself pushed: that previously made a newly added local
+// comment look "already exported" and silently drop it.
+func TestExporterSyncCommentsSkipsExternalComments(t *testing.T) {
+	var created []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/o/r/issues/1/comments", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+		var body struct {
+			Body string `json:"body"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		created = append(created, body.Body)
+		json.NewEncoder(w).Encode(&github.IssueComment{ID: github.Int64(int64(len(created)))})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+
+	e := &Exporter{Client: client, Owner: "o", Repo: "r"}
+
+	// The issue already has one comment on GitHub that the exporter
+	// never created (e.g. a maintainer reply), so PushedCommentCount
+	// starts at zero even though the issue's total comment count is 1.
+	state := &IssueState{IssueNumber: 1}
+	def := &IssueDefinition{
+		SourcePath: "issue.json",
+		Comments:   []string{"first local comment"},
+	}
+
+	if err := e.syncComments(context.Background(), state, def); err != nil {
+		t.Fatalf("syncComments returned error: %v", err)
+	}
+	if len(created) != 1 || created[0] != "first local comment" {
+		t.Fatalf("expected the local comment to be pushed once, got %v", created)
+	}
+	if state.PushedCommentCount != 1 {
+		t.Fatalf("expected PushedCommentCount=1, got %d", state.PushedCommentCount)
+	}
+
+	// Re-running with no new local comments must not re-push anything.
+	if err := e.syncComments(context.Background(), state, def); err != nil {
+		t.Fatalf("second syncComments returned error: %v", err)
+	}
+	if len(created) != 1 {
+		t.Fatalf("expected no additional comments on an unchanged sync, got %v", created)
+	}
+
+	// Adding a second local comment should push only the new one.
+	def.Comments = append(def.Comments, "second local comment")
+	if err := e.syncComments(context.Background(), state, def); err != nil {
+		t.Fatalf("third syncComments returned error: %v", err)
+	}
+	if len(created) != 2 || created[1] != "second local comment" {
+		t.Fatalf("expected exactly the new comment to be pushed, got %v", created)
+	}
+}
@@ -0,0 +1,88 @@
+// Package exporter implements a one-way bridge that reconciles a directory
+// of file-backed issue definitions with GitHub issues, so that issue
+// content can live in a repository and be pushed declaratively.
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// IssueDefinition is the on-disk representation of an issue to be
+// reconciled into GitHub. Comments are append-only: entries already
+// present (by position) in state are never re-sent.
+type IssueDefinition struct {
+	SourcePath string   `json:"-" yaml:"-"`
+	Title      string   `json:"title" yaml:"title"`
+	Body       string   `json:"body" yaml:"body"`
+	Labels     []string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Assignees  []string `json:"assignees,omitempty" yaml:"assignees,omitempty"`
+	Milestone  int      `json:"milestone,omitempty" yaml:"milestone,omitempty"`
+	Comments   []string `json:"comments,omitempty" yaml:"comments,omitempty"`
+}
+
+// LoadDir walks dir and parses every ".json", ".yaml" and ".yml" file it
+// finds into an IssueDefinition. Files modified at or before the since
+// mtime are skipped entirely, which lets incremental exports avoid
+// re-reading unchanged issue definitions.
+func LoadDir(dir string, since int64) ([]*IssueDefinition, error) {
+	var definitions []*IssueDefinition
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		ext := strings.ToLower(filepath.Ext(path))
+		if ext != ".json" && ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		if since > 0 && info.ModTime().Unix() <= since {
+			return nil
+		}
+
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		def := &IssueDefinition{}
+		if ext == ".json" {
+			err = json.Unmarshal(raw, def)
+		} else {
+			err = yaml.Unmarshal(raw, def)
+		}
+		if err != nil {
+			return fmt.Errorf("parsing issue definition %s: %w", path, err)
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			rel = path
+		}
+		def.SourcePath = rel
+
+		definitions = append(definitions, def)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].SourcePath < definitions[j].SourcePath
+	})
+
+	return definitions, nil
+}
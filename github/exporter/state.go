@@ -0,0 +1,15 @@
+package exporter
+
+// IssueState is the stable mapping persisted in Terraform state for a
+// single source file, so that repeated Sync calls are idempotent and
+// only push diffs rather than recreating issues on every apply.
+type IssueState struct {
+	IssueNumber        int    `json:"issue_number"`
+	LastExportedETag   string `json:"last_exported_etag"`
+	LastCommentID      int64  `json:"last_comment_id"`
+	PushedCommentCount int    `json:"pushed_comment_count"`
+}
+
+// SyncState maps a definition's SourcePath to the IssueState GitHub
+// resolved it to.
+type SyncState map[string]*IssueState
@@ -0,0 +1,399 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// httpCacheCtxKey is a private type so ctxHTTPCacheDisabled can't collide
+// with context keys defined by other packages.
+type httpCacheCtxKey string
+
+// ctxHTTPCacheDisabled lets an individual resource opt out of the shared
+// conditional-request cache by threading this key through its context,
+// the same way ctxEtag and ctxId are already threaded through Read.
+const ctxHTTPCacheDisabled httpCacheCtxKey = "http_cache_disabled"
+
+// HTTPCacheConfig is the provider-level `http_cache { ... }` block.
+type HTTPCacheConfig struct {
+	Enabled   bool
+	Backend   string
+	Path      string
+	MaxSizeMB int
+}
+
+// httpCacheProviderSchema is merged into the top-level provider Schema
+// map under the "http_cache" key, alongside the other provider-level
+// blocks.
+func httpCacheProviderSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"backend": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "disk",
+					ValidateFunc: func(v interface{}, k string) ([]string, []error) {
+						value := v.(string)
+						if value != "disk" && value != "memory" {
+							return nil, []error{fmt.Errorf("%s must be one of \"disk\" or \"memory\", got: %s", k, value)}
+						}
+						return nil, nil
+					},
+				},
+				"path": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "~/.terraform.d/github-http-cache",
+				},
+				"max_size_mb": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  100,
+				},
+			},
+		},
+	}
+}
+
+// expandHTTPCacheConfig reads the single `http_cache` block, if any,
+// out of the provider's ResourceData.
+func expandHTTPCacheConfig(d *schema.ResourceData) *HTTPCacheConfig {
+	blocks := d.Get("http_cache").([]interface{})
+	if len(blocks) == 0 {
+		return &HTTPCacheConfig{}
+	}
+
+	block := blocks[0].(map[string]interface{})
+	return &HTTPCacheConfig{
+		Enabled:   block["enabled"].(bool),
+		Backend:   block["backend"].(string),
+		Path:      block["path"].(string),
+		MaxSizeMB: block["max_size_mb"].(int),
+	}
+}
+
+// newHTTPCacheTransport builds the RoundTripper chain for the v3 client:
+// rate-limit awareness on every call, and conditional-request caching
+// layered on top when enabled.
+func newHTTPCacheTransport(next http.RoundTripper, cfg *HTTPCacheConfig) http.RoundTripper {
+	transport := newRateLimitRoundTripper(next)
+	if cfg == nil || !cfg.Enabled {
+		return transport
+	}
+
+	var cache httpCache
+	if cfg.Backend == "memory" {
+		cache = newMemoryHTTPCache(cfg.MaxSizeMB)
+	} else {
+		cache = newDiskHTTPCache(expandHomeDir(cfg.Path), cfg.MaxSizeMB)
+	}
+
+	return newCachingRoundTripper(transport, cache)
+}
+
+// expandHomeDir expands a leading "~" the way a shell would, since
+// neither os nor filepath do this for us.
+func expandHomeDir(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	if path == "~" {
+		return home
+	}
+	return filepath.Join(home, path[2:])
+}
+
+// cacheEntry is what gets persisted for a single cached response.
+type cacheEntry struct {
+	ETag         string      `json:"etag"`
+	LastModified string      `json:"last_modified"`
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+	StoredAt     time.Time   `json:"stored_at"`
+}
+
+// httpCache is the minimal persistence contract the caching transport
+// needs; diskHTTPCache and memoryHTTPCache both satisfy it.
+type httpCache interface {
+	Get(key string) (*cacheEntry, bool)
+	Set(key string, entry *cacheEntry) error
+}
+
+// memoryHTTPCache is an in-process cache, bounded by an approximate
+// total body size rather than entry count.
+type memoryHTTPCache struct {
+	mu          sync.Mutex
+	maxBytes    int
+	usedBytes   int
+	entries     map[string]*cacheEntry
+	insertOrder []string
+}
+
+func newMemoryHTTPCache(maxSizeMB int) *memoryHTTPCache {
+	return &memoryHTTPCache{
+		maxBytes: maxSizeMB * 1024 * 1024,
+		entries:  map[string]*cacheEntry{},
+	}
+}
+
+func (c *memoryHTTPCache) Get(key string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+func (c *memoryHTTPCache) Set(key string, entry *cacheEntry) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if old, exists := c.entries[key]; exists {
+		c.usedBytes -= len(old.Body)
+	} else {
+		c.insertOrder = append(c.insertOrder, key)
+	}
+	c.entries[key] = entry
+	c.usedBytes += len(entry.Body)
+
+	for c.maxBytes > 0 && c.usedBytes > c.maxBytes && len(c.insertOrder) > 0 {
+		oldest := c.insertOrder[0]
+		c.insertOrder = c.insertOrder[1:]
+		if old, ok := c.entries[oldest]; ok {
+			c.usedBytes -= len(old.Body)
+			delete(c.entries, oldest)
+		}
+	}
+
+	return nil
+}
+
+// diskHTTPCache persists one JSON file per cache key under dir, so the
+// cache survives across plan/apply invocations. It enforces maxBytes by
+// evicting the least-recently-written files once the directory grows
+// past it, the same bound memoryHTTPCache enforces in-process.
+type diskHTTPCache struct {
+	dir      string
+	maxBytes int
+}
+
+func newDiskHTTPCache(dir string, maxSizeMB int) *diskHTTPCache {
+	return &diskHTTPCache{dir: dir, maxBytes: maxSizeMB * 1024 * 1024}
+}
+
+func (c *diskHTTPCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *diskHTTPCache) Get(key string) (*cacheEntry, bool) {
+	raw, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	entry := &cacheEntry{}
+	if err := json.Unmarshal(raw, entry); err != nil {
+		return nil, false
+	}
+	return entry, true
+}
+
+func (c *diskHTTPCache) Set(key string, entry *cacheEntry) error {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(c.path(key), raw, 0644); err != nil {
+		return err
+	}
+	return c.evictOverBudget()
+}
+
+// evictOverBudget removes the oldest-modified cache files until the
+// directory's total size is back under maxBytes.
+func (c *diskHTTPCache) evictOverBudget() error {
+	if c.maxBytes <= 0 {
+		return nil
+	}
+
+	files, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	for _, f := range files {
+		total += f.Size()
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+
+	for _, f := range files {
+		if total <= int64(c.maxBytes) {
+			break
+		}
+		if err := os.Remove(filepath.Join(c.dir, f.Name())); err != nil {
+			continue
+		}
+		total -= f.Size()
+	}
+
+	return nil
+}
+
+// cachingRoundTripper wraps an http.RoundTripper and participates in
+// GitHub's conditional-request protocol: it attaches If-None-Match and
+// If-Modified-Since from the last response seen for a given request,
+// and on a 304 it returns the cached body instead of the empty one,
+// without the round trip counting against the primary rate limit.
+type cachingRoundTripper struct {
+	next  http.RoundTripper
+	cache httpCache
+}
+
+func newCachingRoundTripper(next http.RoundTripper, cache httpCache) *cachingRoundTripper {
+	return &cachingRoundTripper{next: next, cache: cache}
+}
+
+func (t *cachingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Context().Value(ctxHTTPCacheDisabled) == true {
+		return t.next.RoundTrip(req)
+	}
+
+	key := req.Method + " " + req.URL.String()
+	cached, hit := t.cache.Get(key)
+	if hit {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if hit && resp.StatusCode == http.StatusNotModified {
+		log.Printf("[DEBUG] http cache: %s not modified, serving cached body", key)
+		resp.Body.Close()
+		resp.StatusCode = cached.StatusCode
+		resp.Status = strconv.Itoa(cached.StatusCode)
+		// Swap in the cached status and body only. The 304's own headers
+		// are kept as-is, since they carry GitHub's *current*
+		// X-Ratelimit-Remaining/X-Ratelimit-Reset — overwriting them with
+		// the stale cached header set would make every cache hit look
+		// like it happened at the time the entry was stored.
+		resp.Body = ioutil.NopCloser(bytes.NewReader(cached.Body))
+		return resp, nil
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		body, readErr := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			return nil, readErr
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		_ = t.cache.Set(key, &cacheEntry{
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+			StatusCode:   resp.StatusCode,
+			Header:       resp.Header,
+			Body:         body,
+			StoredAt:     time.Now(),
+		})
+	}
+
+	return resp, nil
+}
+
+// rateLimitRoundTripper logs the remaining primary rate-limit budget on
+// every response and, once it is nearly exhausted, sleeps until the
+// window resets rather than letting the next call 403.
+type rateLimitRoundTripper struct {
+	next         http.RoundTripper
+	lowWaterMark int
+}
+
+func newRateLimitRoundTripper(next http.RoundTripper) *rateLimitRoundTripper {
+	return &rateLimitRoundTripper{next: next, lowWaterMark: 5}
+}
+
+func (t *rateLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	remaining, rErr := strconv.Atoi(resp.Header.Get("X-Ratelimit-Remaining"))
+	reset, tErr := strconv.ParseInt(resp.Header.Get("X-Ratelimit-Reset"), 10, 64)
+	if rErr != nil || tErr != nil {
+		return resp, nil
+	}
+
+	log.Printf("[DEBUG] github: %d requests remaining until %s", remaining, time.Unix(reset, 0))
+
+	if remaining > t.lowWaterMark {
+		return resp, nil
+	}
+
+	sleep := time.Until(time.Unix(reset, 0))
+	if sleep <= 0 {
+		return resp, nil
+	}
+
+	log.Printf("[WARN] github: rate limit nearly exhausted (%d remaining), sleeping %s until reset", remaining, sleep)
+	select {
+	case <-time.After(sleep):
+	case <-req.Context().Done():
+	}
+
+	return resp, nil
+}
+
+// withHTTPCacheDisabled marks ctx so the caching transport passes its
+// requests straight through, for resources that opt out of the shared
+// cache.
+func withHTTPCacheDisabled(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxHTTPCacheDisabled, true)
+}
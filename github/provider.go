@@ -0,0 +1,52 @@
+package github
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Provider returns the schema.Provider for this plugin, wiring every
+// resource and data source in this package together with the
+// provider-level configuration block.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"base_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"http_cache": httpCacheProviderSchema(),
+		},
+		ResourcesMap: map[string]*schema.Resource{
+			"github_issue":                  resourceGithubIssue(),
+			"github_issue_comment":          resourceGithubIssueComment(),
+			"github_issue_reaction":         resourceGithubIssueReaction(),
+			"github_issue_comment_reaction": resourceGithubIssueCommentReaction(),
+			"github_issue_mirror":           resourceGithubIssueMirror(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"github_issue_comment": dataSourceGithubIssueComment(),
+			"github_issues":        dataSourceGithubIssues(),
+		},
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := &Config{
+		Token:     d.Get("token").(string),
+		Owner:     d.Get("owner").(string),
+		BaseURL:   d.Get("base_url").(string),
+		HTTPCache: expandHTTPCacheConfig(d),
+	}
+
+	return config.Meta()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-github/v32/github"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/shurcooL/githubv4"
 )
 
 func resourceGithubIssue() *schema.Resource {
@@ -69,6 +70,24 @@ func resourceGithubIssue() *schema.Resource {
 				}, true),
 				Default: "open",
 			},
+			"on_destroy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"close", "lock", "close_and_lock", "transfer", "noop",
+				}, false),
+				Default: "close_and_lock",
+			},
+			"transfer_to_repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Repository (in OWNER/NAME form) to transfer the issue to when on_destroy is \"transfer\".",
+			},
+			"skip_http_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Opt this resource out of the provider's shared http_cache, forcing every Read to hit GitHub directly.",
+			},
 			"labels": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -144,6 +163,9 @@ func resourceGithubIssueRead(d *schema.ResourceData, meta interface{}) error {
 	if !d.IsNewResource() {
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
+	if d.Get("skip_http_cache").(bool) {
+		ctx = withHTTPCacheDisabled(ctx)
+	}
 
 	owner := d.Get("owner").(string)
 	repo := d.Get("repository").(string)
@@ -211,20 +233,77 @@ func resourceGithubIssueDelete(d *schema.ResourceData, meta interface{}) error {
 
 	owner := d.Get("owner").(string)
 	repo := d.Get("repository").(string)
-
-	log.Printf("[DEBUG] Deleting project Card: %s", d.Id())
 	issueNumber := d.Get("number").(int)
+	onDestroy := d.Get("on_destroy").(string)
+
+	log.Printf("[DEBUG] Deleting issue %s/%s#%d with on_destroy=%s", owner, repo, issueNumber, onDestroy)
 
-	options := github.LockIssueOptions{
-		LockReason: d.Get("lock_reason").(string),
+	switch onDestroy {
+	case "close":
+		return resourceGithubIssueClose(ctx, client, owner, repo, issueNumber)
+	case "lock":
+		return resourceGithubIssueLock(ctx, client, owner, repo, issueNumber, d.Get("lock_reason").(string))
+	case "close_and_lock":
+		if err := resourceGithubIssueClose(ctx, client, owner, repo, issueNumber); err != nil {
+			return err
+		}
+		return resourceGithubIssueLock(ctx, client, owner, repo, issueNumber, d.Get("lock_reason").(string))
+	case "transfer":
+		transferTo := d.Get("transfer_to_repository").(string)
+		if transferTo == "" {
+			return fmt.Errorf("transfer_to_repository must be set when on_destroy is \"transfer\"")
+		}
+		return resourceGithubIssueTransfer(ctx, meta.(*Owner), owner, repo, issueNumber, transferTo)
+	case "noop":
+		return nil
+	default:
+		return fmt.Errorf("unsupported on_destroy value: %s", onDestroy)
 	}
+}
+
+func resourceGithubIssueClose(ctx context.Context, client *github.Client, owner, repo string, issueNumber int) error {
+	_, _, err := client.Issues.Edit(ctx, owner, repo, issueNumber, &github.IssueRequest{
+		State: github.String("closed"),
+	})
+	return err
+}
 
-	_, err := client.Issues.Lock(ctx, owner, repo, issueNumber, &options)
+func resourceGithubIssueLock(ctx context.Context, client *github.Client, owner, repo string, issueNumber int, lockReason string) error {
+	_, err := client.Issues.Lock(ctx, owner, repo, issueNumber, &github.LockIssueOptions{
+		LockReason: lockReason,
+	})
+	return err
+}
+
+func resourceGithubIssueTransfer(ctx context.Context, owner *Owner, repoOwner, repo string, issueNumber int, transferToRepository string) error {
+	parts := strings.SplitN(transferToRepository, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("transfer_to_repository must be in OWNER/REPOSITORY form, got: %s", transferToRepository)
+	}
+
+	issue, _, err := owner.v3client.Issues.Get(ctx, repoOwner, repo, issueNumber)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	targetRepo, _, err := owner.v3client.Repositories.Get(ctx, parts[0], parts[1])
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		TransferIssue struct {
+			Issue struct {
+				ID githubv4.ID
+			}
+		} `graphql:"transferIssue(input: $input)"`
+	}
+	input := githubv4.TransferIssueInput{
+		IssueID:      githubv4.ID(issue.GetNodeID()),
+		RepositoryID: githubv4.ID(targetRepo.GetNodeID()),
+	}
+
+	return owner.v4client.Mutate(ctx, &mutation, input, nil)
 }
 
 func expandIssueUsers(users []*github.User) []string {
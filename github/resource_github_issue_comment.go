@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceGithubIssueComment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubIssueCommentCreate,
+		Read:   resourceGithubIssueCommentRead,
+		Update: resourceGithubIssueCommentUpdate,
+		Delete: resourceGithubIssueCommentDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+					return nil, fmt.Errorf("Invalid ID format, must be provided as OWNER/REPOSITORY/COMMENT_ID")
+				}
+				d.Set("owner", parts[0])
+				d.Set("repository", parts[1])
+				commentID, err := strconv.ParseInt(parts[2], 10, 64)
+				if err != nil {
+					return nil, err
+				}
+				d.SetId(fmt.Sprintf("%s/%s/%d", parts[0], parts[1], commentID))
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"issue_number": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"body": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"author_association": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubIssueCommentCreate(d *schema.ResourceData, meta interface{}) error {
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+	issueNumber := d.Get("issue_number").(int)
+
+	log.Printf("[DEBUG] Creating issue comment on %s/%s#%d", owner, repo, issueNumber)
+	client := meta.(*Owner).v3client
+	comment := &github.IssueComment{
+		Body: github.String(d.Get("body").(string)),
+	}
+
+	ctx := context.Background()
+	comment, _, err := client.Issues.CreateComment(ctx, owner, repo, issueNumber, comment)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", owner, repo, comment.GetID()))
+
+	return resourceGithubIssueCommentRead(d, meta)
+}
+
+func resourceGithubIssueCommentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+
+	owner, repo, commentID, err := parseGithubIssueCommentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
+	}
+
+	log.Printf("[DEBUG] Reading issue comment: %s", d.Id())
+	comment, _, err := client.Issues.GetComment(ctx, owner, repo, commentID)
+	if err != nil {
+		if err, ok := err.(*github.ErrorResponse); ok {
+			if err.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] Removing issue comment %s from state because it no longer exists in GitHub", d.Id())
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("owner", owner)
+	d.Set("repository", repo)
+	d.Set("body", comment.GetBody())
+	d.Set("comment_id", comment.GetID())
+	d.Set("node_id", comment.GetNodeID())
+	d.Set("author_association", comment.GetAuthorAssociation())
+	d.Set("html_url", comment.GetHTMLURL())
+	d.Set("created_at", comment.GetCreatedAt().Format(time.RFC3339))
+	d.Set("updated_at", comment.GetUpdatedAt().Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceGithubIssueCommentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+
+	owner, repo, commentID, err := parseGithubIssueCommentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	comment := &github.IssueComment{
+		Body: github.String(d.Get("body").(string)),
+	}
+
+	ctx := context.Background()
+	_, _, err = client.Issues.EditComment(ctx, owner, repo, commentID, comment)
+	if err != nil {
+		return err
+	}
+
+	return resourceGithubIssueCommentRead(d, meta)
+}
+
+func resourceGithubIssueCommentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	owner, repo, commentID, err := parseGithubIssueCommentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting issue comment: %s", d.Id())
+	_, err = client.Issues.DeleteComment(ctx, owner, repo, commentID)
+	return err
+}
+
+func parseGithubIssueCommentID(id string) (owner, repo string, commentID int64, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", 0, fmt.Errorf("Invalid ID format, must be provided as OWNER/REPOSITORY/COMMENT_ID")
+	}
+	commentID, err = strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", "", 0, err
+	}
+	return parts[0], parts[1], commentID, nil
+}
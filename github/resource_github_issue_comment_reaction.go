@@ -0,0 +1,161 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceGithubIssueCommentReaction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubIssueCommentReactionCreate,
+		Read:   resourceGithubIssueCommentReactionRead,
+		Delete: resourceGithubIssueCommentReactionDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+					return nil, fmt.Errorf("Invalid ID format, must be provided as OWNER/REPOSITORY/COMMENT_ID/REACTION_ID")
+				}
+				d.Set("owner", parts[0])
+				d.Set("repository", parts[1])
+				commentID, err := strconv.ParseInt(parts[2], 10, 64)
+				if err != nil {
+					return nil, err
+				}
+				d.Set("comment_id", commentID)
+				d.SetId(d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"comment_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(githubIssueReactionTypes, false),
+			},
+			"reaction_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubIssueCommentReactionCreate(d *schema.ResourceData, meta interface{}) error {
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+	commentID := int64(d.Get("comment_id").(int))
+	content := d.Get("content").(string)
+
+	log.Printf("[DEBUG] Creating %q reaction on %s/%s comment %d", content, owner, repo, commentID)
+	client := meta.(*Owner).v3client
+
+	ctx := context.Background()
+	reaction, _, err := client.Reactions.CreateIssueCommentReaction(ctx, owner, repo, commentID, content)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d/%d", owner, repo, commentID, reaction.GetID()))
+
+	return resourceGithubIssueCommentReactionRead(d, meta)
+}
+
+func resourceGithubIssueCommentReactionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+
+	owner, repo, commentID, reactionID, err := parseGithubIssueCommentReactionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading issue comment reactions: %s", d.Id())
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		reactions, resp, err := client.Reactions.ListIssueCommentReactions(ctx, owner, repo, commentID, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, reaction := range reactions {
+			if reaction.GetID() == reactionID {
+				d.Set("owner", owner)
+				d.Set("repository", repo)
+				d.Set("comment_id", commentID)
+				d.Set("content", reaction.GetContent())
+				d.Set("reaction_id", reaction.GetID())
+				d.Set("user", reaction.GetUser().GetLogin())
+				return nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	log.Printf("[WARN] Removing issue comment reaction %s from state because it no longer exists in GitHub", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceGithubIssueCommentReactionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	owner, repo, commentID, reactionID, err := parseGithubIssueCommentReactionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting issue comment reaction: %s", d.Id())
+	_, err = client.Reactions.DeleteIssueCommentReaction(ctx, owner, repo, commentID, reactionID)
+	return err
+}
+
+func parseGithubIssueCommentReactionID(id string) (owner, repo string, commentID int64, reactionID int64, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", 0, 0, fmt.Errorf("Invalid ID format, must be provided as OWNER/REPOSITORY/COMMENT_ID/REACTION_ID")
+	}
+	commentID, err = strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", "", 0, 0, err
+	}
+	reactionID, err = strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return "", "", 0, 0, err
+	}
+	return parts[0], parts[1], commentID, reactionID, nil
+}
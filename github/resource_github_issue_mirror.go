@@ -0,0 +1,181 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/GrooveCommunity/terraform-provider-github/github/exporter"
+)
+
+func resourceGithubIssueMirror() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubIssueMirrorCreateOrUpdate,
+		Read:   resourceGithubIssueMirrorRead,
+		Update: resourceGithubIssueMirrorCreateOrUpdate,
+		Delete: resourceGithubIssueMirrorDelete,
+
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"since": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "RFC3339 timestamp; issue files with an mtime at or before this are skipped. Defaults to the last successful sync.",
+			},
+			"last_synced_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"issue_mappings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issue_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_exported_etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_comment_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"pushed_comment_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGithubIssueMirrorCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+	sourceDir := d.Get("source_dir").(string)
+
+	var since int64
+	if raw, ok := d.GetOk("since"); ok && raw.(string) != "" {
+		t, err := time.Parse(time.RFC3339, raw.(string))
+		if err != nil {
+			return err
+		}
+		since = t.Unix()
+	} else if raw, ok := d.GetOk("last_synced_at"); ok && raw.(string) != "" {
+		t, err := time.Parse(time.RFC3339, raw.(string))
+		if err == nil {
+			since = t.Unix()
+		}
+	}
+
+	definitions, err := exporter.LoadDir(sourceDir, since)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Mirroring %d issue definitions from %s into %s/%s", len(definitions), sourceDir, owner, repo)
+
+	client := meta.(*Owner).v3client
+	exp := exporter.New(client, owner, repo)
+
+	state := expandIssueMirrorState(d.Get("issue_mappings").([]interface{}))
+
+	ctx := context.Background()
+	state, err = exp.Sync(ctx, definitions, state)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repo, sourceDir))
+	d.Set("last_synced_at", time.Now().UTC().Format(time.RFC3339))
+	d.Set("issue_mappings", flattenIssueMirrorState(state))
+
+	return nil
+}
+
+func resourceGithubIssueMirrorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+
+	state := expandIssueMirrorState(d.Get("issue_mappings").([]interface{}))
+	ctx := context.Background()
+
+	for sourcePath, s := range state {
+		issue, _, err := client.Issues.Get(ctx, owner, repo, s.IssueNumber)
+		if err != nil {
+			if ghErr, ok := err.(*github.ErrorResponse); ok && ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[WARN] issue mirror: %s (issue #%d) no longer exists in GitHub, dropping from state", sourcePath, s.IssueNumber)
+				delete(state, sourcePath)
+				continue
+			}
+			return err
+		}
+		s.LastExportedETag = issue.GetNodeID()
+	}
+
+	d.Set("issue_mappings", flattenIssueMirrorState(state))
+
+	return nil
+}
+
+func resourceGithubIssueMirrorDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing issue mirror %s from state; this does not delete mirrored GitHub issues", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func expandIssueMirrorState(raw []interface{}) exporter.SyncState {
+	state := exporter.SyncState{}
+	for _, v := range raw {
+		m := v.(map[string]interface{})
+		state[m["source_path"].(string)] = &exporter.IssueState{
+			IssueNumber:        m["issue_number"].(int),
+			LastExportedETag:   m["last_exported_etag"].(string),
+			LastCommentID:      int64(m["last_comment_id"].(int)),
+			PushedCommentCount: m["pushed_comment_count"].(int),
+		}
+	}
+	return state
+}
+
+func flattenIssueMirrorState(state exporter.SyncState) []interface{} {
+	mappings := make([]interface{}, 0, len(state))
+	for sourcePath, s := range state {
+		mappings = append(mappings, map[string]interface{}{
+			"source_path":          sourcePath,
+			"issue_number":         s.IssueNumber,
+			"last_exported_etag":   s.LastExportedETag,
+			"last_comment_id":      int(s.LastCommentID),
+			"pushed_comment_count": s.PushedCommentCount,
+		})
+	}
+	return mappings
+}
@@ -0,0 +1,163 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var githubIssueReactionTypes = []string{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"}
+
+func resourceGithubIssueReaction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGithubIssueReactionCreate,
+		Read:   resourceGithubIssueReactionRead,
+		Delete: resourceGithubIssueReactionDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+					return nil, fmt.Errorf("Invalid ID format, must be provided as OWNER/REPOSITORY/ISSUE_NUMBER/REACTION_ID")
+				}
+				d.Set("owner", parts[0])
+				d.Set("repository", parts[1])
+				issueNumber, err := strconv.Atoi(parts[2])
+				if err != nil {
+					return nil, err
+				}
+				d.Set("issue_number", issueNumber)
+				d.SetId(d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"issue_number": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(githubIssueReactionTypes, false),
+			},
+			"reaction_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGithubIssueReactionCreate(d *schema.ResourceData, meta interface{}) error {
+	owner := d.Get("owner").(string)
+	repo := d.Get("repository").(string)
+	issueNumber := d.Get("issue_number").(int)
+	content := d.Get("content").(string)
+
+	log.Printf("[DEBUG] Creating %q reaction on %s/%s#%d", content, owner, repo, issueNumber)
+	client := meta.(*Owner).v3client
+
+	ctx := context.Background()
+	reaction, _, err := client.Reactions.CreateIssueReaction(ctx, owner, repo, issueNumber, content)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d/%d", owner, repo, issueNumber, reaction.GetID()))
+
+	return resourceGithubIssueReactionRead(d, meta)
+}
+
+func resourceGithubIssueReactionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+
+	owner, repo, issueNumber, reactionID, err := parseGithubIssueReactionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	log.Printf("[DEBUG] Reading issue reactions: %s", d.Id())
+	opts := &github.ListOptions{PerPage: 100}
+	for {
+		reactions, resp, err := client.Reactions.ListIssueReactions(ctx, owner, repo, issueNumber, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, reaction := range reactions {
+			if reaction.GetID() == reactionID {
+				d.Set("owner", owner)
+				d.Set("repository", repo)
+				d.Set("issue_number", issueNumber)
+				d.Set("content", reaction.GetContent())
+				d.Set("reaction_id", reaction.GetID())
+				d.Set("user", reaction.GetUser().GetLogin())
+				return nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	log.Printf("[WARN] Removing issue reaction %s from state because it no longer exists in GitHub", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceGithubIssueReactionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Owner).v3client
+	ctx := context.WithValue(context.Background(), ctxId, d.Id())
+
+	owner, repo, issueNumber, reactionID, err := parseGithubIssueReactionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting issue reaction: %s", d.Id())
+	_, err = client.Reactions.DeleteIssueReaction(ctx, owner, repo, issueNumber, reactionID)
+	return err
+}
+
+func parseGithubIssueReactionID(id string) (owner, repo string, issueNumber int, reactionID int64, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", 0, 0, fmt.Errorf("Invalid ID format, must be provided as OWNER/REPOSITORY/ISSUE_NUMBER/REACTION_ID")
+	}
+	issueNumber, err = strconv.Atoi(parts[2])
+	if err != nil {
+		return "", "", 0, 0, err
+	}
+	reactionID, err = strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return "", "", 0, 0, err
+	}
+	return parts[0], parts[1], issueNumber, reactionID, nil
+}